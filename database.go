@@ -0,0 +1,119 @@
+package datainfo
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// connStrRewriter rewrites old so it targets newDB instead, without otherwise changing the
+// connection. It is used by WithDatabase when a driver rejects `USE <db>` at runtime and a
+// brand new *sql.DB has to be opened against the other database instead.
+type connStrRewriter = func(old, newDB string) (string, error)
+
+// connectionStringRewriters holds the built-in, driver-aware rewriters used by WithDatabase
+// when ConnectionStringRewriter is not set.
+var connectionStringRewriters = map[string]connStrRewriter{
+	"mssql":     rewriteMSSQLDatabase,
+	"sqlserver": rewriteMSSQLDatabase,
+	"postgres":  rewritePostgresDatabase,
+	"pgx":       rewritePostgresDatabase,
+	"mysql":     rewriteMySQLDatabase,
+}
+
+// ConnectionStringRewriter sets the rewriter WithDatabase uses to point ConnectionString at a
+// different database. Leave unset to use the built-in rewriter matching DriverName.
+func ConnectionStringRewriter(rewriter connStrRewriter) DataOption {
+	return func(d *DataInfo) error {
+		d.ConnectionStringRewriter = rewriter
+		return nil
+	}
+}
+
+// WithDatabase returns a Copy of d whose ConnectionString targets database name instead,
+// using ConnectionStringRewriter (or the built-in rewriter matching DriverName when unset).
+// This exists because some drivers, notably current go-mssqldb, refuse `USE <db>` at runtime
+// and require opening a brand new *sql.DB against the other database.
+func (d *DataInfo) WithDatabase(name string) (*DataInfo, error) {
+	if d.ConnectionString == nil {
+		return nil, fmt.Errorf("datainfo: connection string not set")
+	}
+	rewriter := d.ConnectionStringRewriter
+	if rewriter == nil {
+		driver := ""
+		if d.DriverName != nil {
+			driver = *d.DriverName
+		}
+		rewriter = connectionStringRewriters[strings.ToLower(driver)]
+		if rewriter == nil {
+			return nil, fmt.Errorf("datainfo: no connection string rewriter registered for driver %q", driver)
+		}
+	}
+	newConnStr, err := rewriter(*d.ConnectionString, name)
+	if err != nil {
+		return nil, fmt.Errorf("datainfo: rewrite connection string: %w", err)
+	}
+	n := Copy(d)
+	n.ConnectionString = new(string)
+	*n.ConnectionString = newConnStr
+	return n, nil
+}
+
+// mssqlDatabaseKey matches the ADO-style `database=` or `initial catalog=` key, case
+// insensitively. The `:` alternative also matches the key when it is the first parameter
+// right after an `odbc:` prefix, e.g. `odbc:database=master;server=...`.
+var mssqlDatabaseKey = regexp.MustCompile(`(?i)(^|;|:)\s*(database|initial catalog)\s*=\s*[^;]*`)
+
+func rewriteMSSQLDatabase(old, newDB string) (string, error) {
+	if strings.Contains(old, "://") {
+		return rewriteURLDatabase(old, newDB, "database")
+	}
+	if mssqlDatabaseKey.MatchString(old) {
+		return mssqlDatabaseKey.ReplaceAllString(old, "${1}${2}="+newDB), nil
+	}
+	return strings.TrimRight(old, ";") + ";database=" + newDB, nil
+}
+
+// postgresDatabaseKey matches the key=value `dbname=` parameter of a postgres key=value DSN.
+var postgresDatabaseKey = regexp.MustCompile(`(?i)(^|\s)dbname=\S*`)
+
+func rewritePostgresDatabase(old, newDB string) (string, error) {
+	if strings.Contains(old, "://") {
+		return rewriteURLDatabase(old, newDB, "")
+	}
+	if postgresDatabaseKey.MatchString(old) {
+		return postgresDatabaseKey.ReplaceAllString(old, "${1}dbname="+newDB), nil
+	}
+	return strings.TrimRight(old, " ") + " dbname=" + newDB, nil
+}
+
+// mysqlDSN splits a mysql DSN of the form `user:pass@tcp(host:port)/dbname?params` so the
+// dbname segment between the last `/` and an optional `?` can be replaced.
+var mysqlDSN = regexp.MustCompile(`^([^?]*/)([^?]*)(\?.*)?$`)
+
+func rewriteMySQLDatabase(old, newDB string) (string, error) {
+	m := mysqlDSN.FindStringSubmatch(old)
+	if m == nil {
+		return "", fmt.Errorf("datainfo: %q is not a recognizable mysql dsn", old)
+	}
+	return m[1] + newDB + m[3], nil
+}
+
+// rewriteURLDatabase replaces the path component of a URL-form connection string with newDB.
+// When pathParam is non-empty, the database is instead carried as that query parameter
+// (used by the sqlserver URL form), and a missing path component is simply left absent.
+func rewriteURLDatabase(old, newDB, pathParam string) (string, error) {
+	u, err := url.Parse(old)
+	if err != nil {
+		return "", fmt.Errorf("datainfo: %w", err)
+	}
+	if pathParam != "" {
+		q := u.Query()
+		q.Set(pathParam, newDB)
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	}
+	u.Path = "/" + newDB
+	return u.String(), nil
+}
@@ -1,6 +1,7 @@
 package datainfo
 
 import (
+	"strings"
 	"time"
 )
 
@@ -19,25 +20,30 @@ type (
 	}
 	DataOption func(do *DataInfo) error
 	DataInfo   struct {
-		Schema                 *string                // Schema to use
-		ReferenceMode          *bool                  // Indicates that the data is in reference mode
-		ReferenceModePrefix    *string                // Reference mode prefix. The default is 'ref'.
-		InterpolateTables      *bool                  // Interpolate tables that has been enclosed by {}
-		ConnectionString       *string                // Connection string of data
-		DriverName             *string                // Driver name to use
-		HelperID               *string                // Helper ID to use
-		ParameterInSequence    *bool                  // Parameter is in sequence
-		ParameterPlaceHolder   *string                // Parameter place holder
-		StringEnclosingChar    *string                // Gets or sets the character that encloses a string in the query
-		StringEscapeChar       *string                // Gets or Sets the character that escapes a reserved character such as the character that encloses a s string
-		ReservedWordEscapeChar *string                // Reserved word escape chars. For escaping with different opening and closing characters, just set to both. Example. `[]` for SQL server
-		MaxOpenConnection      *int                   // Maximum open connection
-		MaxIdleConnection      *int                   // Maximum idle connection
-		MaxConnectionLifetime  *int                   // Max connection lifetime
-		MaxConnectionIdleTime  *int                   // Max idle connection lifetime
-		Ping                   *bool                  // Ping connection
-		ResultLimitPosition    LimitPosition          // For Old SQL Server versions, The limiter is in the front (TOP). For newer SQL Server, LIMIT at the rear is supported.
-		SequenceGenerator      *SequenceGeneratorInfo // Sequence generator
+		Schema                    *string                                 // Schema to use
+		ReferenceMode             *bool                                   // Indicates that the data is in reference mode
+		ReferenceModePrefix       *string                                 // Reference mode prefix. The default is 'ref'.
+		InterpolateTables         *bool                                   // Interpolate tables that has been enclosed by {}
+		ConnectionString          *string                                 // Connection string of data
+		DriverName                *string                                 // Driver name to use
+		HelperID                  *string                                 // Helper ID to use
+		ParameterInSequence       *bool                                   // Parameter is in sequence
+		ParameterPlaceHolder      *string                                 // Parameter place holder
+		ParameterPlaceholderStyle PlaceholderStyle                        // Placeholder syntax used by RewriteQuery. Defaults to Question.
+		StringEnclosingChar       *string                                 // Gets or sets the character that encloses a string in the query
+		StringEscapeChar          *string                                 // Gets or Sets the character that escapes a reserved character such as the character that encloses a s string
+		ReservedWordEscapeChar    *string                                 // Reserved word escape chars. For escaping with different opening and closing characters, just set to both. Example. `[]` for SQL server
+		MaxOpenConnection         *int                                    // Maximum open connection
+		MaxIdleConnection         *int                                    // Maximum idle connection
+		MaxConnectionLifetime     *int                                    // Max connection lifetime
+		MaxConnectionIdleTime     *int                                    // Max idle connection lifetime
+		Ping                      *bool                                   // Ping connection
+		ResultLimitPosition       LimitPosition                           // For Old SQL Server versions, The limiter is in the front (TOP). For newer SQL Server, LIMIT at the rear is supported.
+		SequenceGenerator         *SequenceGeneratorInfo                  // Sequence generator
+		InitStatements            []string                                // SQL statements executed once against every freshly opened connection
+		SessionTimeZone           *string                                 // Time zone applied to every freshly opened connection
+		ConnectionStringRewriter  func(old, newDB string) (string, error) // Rewrites ConnectionString for WithDatabase. Defaults to a driver-aware rewriter matching DriverName.
+		TLS                       *TLSConfig                              // TLS/SSL configuration merged into ConnectionString by RenderConnectionString
 	}
 )
 
@@ -91,16 +97,24 @@ func New(options ...DataOption) *DataInfo {
 		}
 		o(&n)
 	}
+	applySessionTimeZone(&n)
 	return &n
 }
 
 // NewMinimal initializes a common data info by using the minimal set. Further options are accepted via options parameter.
+//
+// When driver matches a registered Dialect, its preset is applied automatically so callers
+// no longer have to restate ParameterPlaceHolder, StringEnclosingChar and friends for every
+// driver. Passing the matching option explicitly in options still overrides the preset.
 func NewMinimal(connStr, schema, driver, user string, options ...DataOption) *DataInfo {
 	opts := []DataOption{
 		ConnectionString(connStr),
 		Schema(schema),
 		DriverName(driver),
 	}
+	if _, ok := dialects[strings.ToLower(driver)]; ok {
+		opts = append(opts, Dialect(driver))
+	}
 	if len(options) > 0 {
 		opts = append(opts, options...)
 	}
@@ -188,12 +202,30 @@ func Copy(di *DataInfo, options ...DataOption) *DataInfo {
 		}
 	}
 	n.ResultLimitPosition = di.ResultLimitPosition
+	n.ParameterPlaceholderStyle = di.ParameterPlaceholderStyle
+	if di.InitStatements != nil {
+		n.InitStatements = make([]string, len(di.InitStatements))
+		copy(n.InitStatements, di.InitStatements)
+	}
+	if di.SessionTimeZone != nil {
+		n.SessionTimeZone = new(string)
+		*n.SessionTimeZone = *di.SessionTimeZone
+	}
+	n.ConnectionStringRewriter = di.ConnectionStringRewriter
+	if di.TLS != nil {
+		tls := *di.TLS
+		n.TLS = &tls
+	}
+	if oldStmt, ok := sessionTimeZoneStatement(di.DriverName, di.SessionTimeZone); ok {
+		n.InitStatements = removeInitStatement(n.InitStatements, oldStmt)
+	}
 	for _, o := range options {
 		if o == nil {
 			continue
 		}
 		o(&n)
 	}
+	applySessionTimeZone(&n)
 	return &n
 }
 
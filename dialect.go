@@ -0,0 +1,123 @@
+package datainfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dialects holds the built-in dialect presets keyed by lower-cased driver name.
+var dialects = map[string]DataOption{}
+
+// RegisterDialect registers a DataOption under name so it can later be applied
+// with Dialect(name) or picked up automatically by NewMinimal. Registering under
+// an existing name replaces the previous preset.
+func RegisterDialect(name string, apply DataOption) {
+	dialects[strings.ToLower(name)] = apply
+}
+
+// Dialect returns a DataOption that applies the preset registered under name.
+// It fails if no dialect has been registered under that name.
+func Dialect(name string) DataOption {
+	return func(d *DataInfo) error {
+		apply, ok := dialects[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("datainfo: no dialect registered for %q", name)
+		}
+		return apply(d)
+	}
+}
+
+func init() {
+	RegisterDialect("mssql", func(d *DataInfo) error {
+		d.ParameterPlaceHolder = new(string)
+		*d.ParameterPlaceHolder = `?`
+		d.ParameterInSequence = new(bool)
+		*d.ParameterInSequence = false
+		d.StringEnclosingChar = new(string)
+		*d.StringEnclosingChar = `'`
+		d.StringEscapeChar = new(string)
+		*d.StringEscapeChar = `'`
+		d.ReservedWordEscapeChar = new(string)
+		*d.ReservedWordEscapeChar = `[]`
+		d.ResultLimitPosition = FRONT
+		d.ParameterPlaceholderStyle = Question
+		return nil
+	})
+	RegisterDialect("sqlserver", func(d *DataInfo) error {
+		d.ParameterPlaceHolder = new(string)
+		*d.ParameterPlaceHolder = `@p`
+		d.ParameterInSequence = new(bool)
+		*d.ParameterInSequence = true
+		d.StringEnclosingChar = new(string)
+		*d.StringEnclosingChar = `'`
+		d.StringEscapeChar = new(string)
+		*d.StringEscapeChar = `'`
+		d.ReservedWordEscapeChar = new(string)
+		*d.ReservedWordEscapeChar = `[]`
+		d.ResultLimitPosition = REAR
+		d.ParameterPlaceholderStyle = AtPn
+		return nil
+	})
+	RegisterDialect("mysql", func(d *DataInfo) error {
+		d.ParameterPlaceHolder = new(string)
+		*d.ParameterPlaceHolder = `?`
+		d.ParameterInSequence = new(bool)
+		*d.ParameterInSequence = true
+		d.StringEnclosingChar = new(string)
+		*d.StringEnclosingChar = `'`
+		d.StringEscapeChar = new(string)
+		*d.StringEscapeChar = `\`
+		d.ReservedWordEscapeChar = new(string)
+		*d.ReservedWordEscapeChar = "``"
+		d.ResultLimitPosition = REAR
+		d.ParameterPlaceholderStyle = Question
+		return nil
+	})
+	RegisterDialect("postgres", func(d *DataInfo) error {
+		d.ParameterPlaceHolder = new(string)
+		*d.ParameterPlaceHolder = `$`
+		d.ParameterInSequence = new(bool)
+		*d.ParameterInSequence = true
+		d.StringEnclosingChar = new(string)
+		*d.StringEnclosingChar = `'`
+		d.StringEscapeChar = new(string)
+		*d.StringEscapeChar = `'`
+		d.ReservedWordEscapeChar = new(string)
+		*d.ReservedWordEscapeChar = `""`
+		d.ResultLimitPosition = REAR
+		d.ParameterPlaceholderStyle = DollarN
+		return nil
+	})
+	RegisterDialect("pgx", dialects["postgres"])
+	RegisterDialect("oracle", func(d *DataInfo) error {
+		d.ParameterPlaceHolder = new(string)
+		*d.ParameterPlaceHolder = `:`
+		d.ParameterInSequence = new(bool)
+		*d.ParameterInSequence = true
+		d.StringEnclosingChar = new(string)
+		*d.StringEnclosingChar = `'`
+		d.StringEscapeChar = new(string)
+		*d.StringEscapeChar = `'`
+		d.ReservedWordEscapeChar = new(string)
+		*d.ReservedWordEscapeChar = `""`
+		d.ResultLimitPosition = REAR
+		d.ParameterPlaceholderStyle = ColonN
+		return nil
+	})
+	RegisterDialect("godror", dialects["oracle"])
+	RegisterDialect("sqlite3", func(d *DataInfo) error {
+		d.ParameterPlaceHolder = new(string)
+		*d.ParameterPlaceHolder = `?`
+		d.ParameterInSequence = new(bool)
+		*d.ParameterInSequence = true
+		d.StringEnclosingChar = new(string)
+		*d.StringEnclosingChar = `'`
+		d.StringEscapeChar = new(string)
+		*d.StringEscapeChar = `'`
+		d.ReservedWordEscapeChar = new(string)
+		*d.ReservedWordEscapeChar = `""`
+		d.ResultLimitPosition = REAR
+		d.ParameterPlaceholderStyle = Question
+		return nil
+	})
+}
@@ -0,0 +1,86 @@
+package datainfo
+
+import "testing"
+
+func TestDialectPresets(t *testing.T) {
+	cases := []struct {
+		driver                 string
+		paramPlaceHolder       string
+		paramInSequence        bool
+		stringEnclosingChar    string
+		stringEscapeChar       string
+		reservedWordEscapeChar string
+		resultLimitPosition    LimitPosition
+		placeholderStyle       PlaceholderStyle
+	}{
+		{"mssql", "?", false, "'", "'", "[]", FRONT, Question},
+		{"sqlserver", "@p", true, "'", "'", "[]", REAR, AtPn},
+		{"mysql", "?", true, "'", `\`, "``", REAR, Question},
+		{"postgres", "$", true, "'", "'", `""`, REAR, DollarN},
+		{"pgx", "$", true, "'", "'", `""`, REAR, DollarN},
+		{"oracle", ":", true, "'", "'", `""`, REAR, ColonN},
+		{"godror", ":", true, "'", "'", `""`, REAR, ColonN},
+		{"sqlite3", "?", true, "'", "'", `""`, REAR, Question},
+	}
+	for _, c := range cases {
+		t.Run(c.driver, func(t *testing.T) {
+			di := New(Dialect(c.driver))
+			if di.ParameterPlaceHolder == nil || *di.ParameterPlaceHolder != c.paramPlaceHolder {
+				t.Errorf("ParameterPlaceHolder: got %v, want %q", di.ParameterPlaceHolder, c.paramPlaceHolder)
+			}
+			if di.ParameterInSequence == nil || *di.ParameterInSequence != c.paramInSequence {
+				t.Errorf("ParameterInSequence: got %v, want %v", di.ParameterInSequence, c.paramInSequence)
+			}
+			if di.StringEnclosingChar == nil || *di.StringEnclosingChar != c.stringEnclosingChar {
+				t.Errorf("StringEnclosingChar: got %v, want %q", di.StringEnclosingChar, c.stringEnclosingChar)
+			}
+			if di.StringEscapeChar == nil || *di.StringEscapeChar != c.stringEscapeChar {
+				t.Errorf("StringEscapeChar: got %v, want %q", di.StringEscapeChar, c.stringEscapeChar)
+			}
+			if di.ReservedWordEscapeChar == nil || *di.ReservedWordEscapeChar != c.reservedWordEscapeChar {
+				t.Errorf("ReservedWordEscapeChar: got %v, want %q", di.ReservedWordEscapeChar, c.reservedWordEscapeChar)
+			}
+			if di.ResultLimitPosition != c.resultLimitPosition {
+				t.Errorf("ResultLimitPosition: got %v, want %v", di.ResultLimitPosition, c.resultLimitPosition)
+			}
+			if di.ParameterPlaceholderStyle != c.placeholderStyle {
+				t.Errorf("ParameterPlaceholderStyle: got %v, want %v", di.ParameterPlaceholderStyle, c.placeholderStyle)
+			}
+		})
+	}
+}
+
+func TestDialectUnknown(t *testing.T) {
+	d := &DataInfo{}
+	if err := Dialect("nosuchdriver")(d); err == nil {
+		t.Fatal("expected an error for an unregistered dialect")
+	}
+}
+
+func TestDialectCaseInsensitive(t *testing.T) {
+	di := New(Dialect("POSTGRES"))
+	if di.ParameterPlaceholderStyle != DollarN {
+		t.Fatalf("Dialect should match driver names case-insensitively")
+	}
+}
+
+func TestNewMinimalAppliesMatchingDialect(t *testing.T) {
+	di := NewMinimal("cs", "sch", "postgres", "user")
+	if di.ParameterPlaceholderStyle != DollarN {
+		t.Fatalf("NewMinimal should auto-apply the postgres dialect")
+	}
+}
+
+func TestNewMinimalExplicitOptionOverridesDialect(t *testing.T) {
+	di := NewMinimal("cs", "sch", "postgres", "user", ParameterPlaceholderStyle(AtPn))
+	if di.ParameterPlaceholderStyle != AtPn {
+		t.Fatalf("an explicit option should override the auto-applied dialect")
+	}
+}
+
+func TestNewMinimalUnknownDriverLeavesDefaults(t *testing.T) {
+	di := NewMinimal("cs", "sch", "nosuchdriver", "user")
+	if di.ParameterPlaceholderStyle != Question {
+		t.Fatalf("an unregistered driver should leave the default placeholder style untouched")
+	}
+}
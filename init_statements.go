@@ -0,0 +1,84 @@
+package datainfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sessionTimeZoneStatements maps a driver name to the statement template used to set the
+// session time zone of a freshly opened connection, e.g. `SET TIME ZONE '%s'` for postgres.
+var sessionTimeZoneStatements = map[string]string{
+	"postgres": `SET TIME ZONE '%s'`,
+	"pgx":      `SET TIME ZONE '%s'`,
+	"mysql":    `SET time_zone = '%s'`,
+	"oracle":   `ALTER SESSION SET TIME_ZONE = '%s'`,
+	"godror":   `ALTER SESSION SET TIME_ZONE = '%s'`,
+}
+
+// InitStatements sets the SQL statements executed once against every freshly opened
+// connection, intended for database/sql's Conn.Raw/ResetSession wiring or a sql.Connector
+// wrapper built from DataInfo. Typical uses are ALTER SESSION SET CURRENT_SCHEMA=... on
+// Oracle or SET search_path TO ... on Postgres.
+func InitStatements(stmts ...string) DataOption {
+	return func(d *DataInfo) error {
+		d.InitStatements = append(d.InitStatements, stmts...)
+		return nil
+	}
+}
+
+// SessionTimeZone sets the time zone to apply to every freshly opened connection. When set,
+// the dialect-appropriate statement for DriverName is automatically prepended to
+// InitStatements; drivers with no known statement are left untouched.
+func SessionTimeZone(tz string) DataOption {
+	return func(d *DataInfo) error {
+		if tz == "" {
+			return nil
+		}
+		d.SessionTimeZone = new(string)
+		*d.SessionTimeZone = tz
+		return nil
+	}
+}
+
+// sessionTimeZoneStatement renders the statement SessionTimeZone would auto-insert for
+// driverName, if any. It is used both to add the current statement and, on Copy, to
+// recognize and strip the previous one before SessionTimeZone changes.
+func sessionTimeZoneStatement(driverName, tz *string) (string, bool) {
+	if tz == nil {
+		return "", false
+	}
+	driver := ""
+	if driverName != nil {
+		driver = *driverName
+	}
+	tmpl, ok := sessionTimeZoneStatements[strings.ToLower(driver)]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(tmpl, *tz), true
+}
+
+// removeInitStatement returns stmts with the first occurrence of stmt removed, if present.
+func removeInitStatement(stmts []string, stmt string) []string {
+	for i, existing := range stmts {
+		if existing == stmt {
+			return append(stmts[:i:i], stmts[i+1:]...)
+		}
+	}
+	return stmts
+}
+
+// applySessionTimeZone prepends the dialect-appropriate session time zone statement to
+// InitStatements when SessionTimeZone is set and DriverName has a known statement template.
+func applySessionTimeZone(d *DataInfo) {
+	stmt, ok := sessionTimeZoneStatement(d.DriverName, d.SessionTimeZone)
+	if !ok {
+		return
+	}
+	for _, existing := range d.InitStatements {
+		if existing == stmt {
+			return
+		}
+	}
+	d.InitStatements = append([]string{stmt}, d.InitStatements...)
+}
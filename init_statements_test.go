@@ -0,0 +1,37 @@
+package datainfo
+
+import "testing"
+
+func TestCopySessionTimeZoneReplacesPriorStatement(t *testing.T) {
+	di := New(DriverName("postgres"), SessionTimeZone("UTC"))
+	n := Copy(di, SessionTimeZone("America/New_York"))
+
+	want := []string{`SET TIME ZONE 'America/New_York'`}
+	if len(n.InitStatements) != len(want) {
+		t.Fatalf("got %v, want %v", n.InitStatements, want)
+	}
+	for i, stmt := range want {
+		if n.InitStatements[i] != stmt {
+			t.Fatalf("got %v, want %v", n.InitStatements, want)
+		}
+	}
+
+	if len(di.InitStatements) != 1 || di.InitStatements[0] != `SET TIME ZONE 'UTC'` {
+		t.Fatalf("Copy must not mutate the original's InitStatements, got %v", di.InitStatements)
+	}
+}
+
+func TestCopySessionTimeZoneKeepsUnrelatedStatements(t *testing.T) {
+	di := New(DriverName("postgres"), SessionTimeZone("UTC"), InitStatements("SET search_path TO app"))
+	n := Copy(di, SessionTimeZone("America/New_York"))
+
+	want := []string{`SET TIME ZONE 'America/New_York'`, "SET search_path TO app"}
+	if len(n.InitStatements) != len(want) {
+		t.Fatalf("got %v, want %v", n.InitStatements, want)
+	}
+	for i, stmt := range want {
+		if n.InitStatements[i] != stmt {
+			t.Fatalf("got %v, want %v", n.InitStatements, want)
+		}
+	}
+}
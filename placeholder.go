@@ -0,0 +1,121 @@
+package datainfo
+
+import (
+	dbsql "database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PlaceholderStyle enum
+const (
+	// Question renders every placeholder as a bare `?`, e.g. mysql and sqlite3.
+	Question PlaceholderStyle = iota
+	// DollarN renders positional placeholders as `$1`, `$2`, ..., e.g. postgres.
+	DollarN
+	// AtPn renders positional placeholders as `@p1`, `@p2`, ..., e.g. sqlserver.
+	AtPn
+	// ColonN renders positional placeholders as `:1`, `:2`, ..., e.g. oracle.
+	ColonN
+	// ColonName renders named placeholders as `:name`, falling back to `:N` when no name is given.
+	ColonName
+)
+
+// PlaceholderStyle identifies the parameter placeholder syntax a driver expects.
+type PlaceholderStyle uint8
+
+// Render renders the placeholder for the 1-based index and, for ColonName, the parameter name.
+func (p PlaceholderStyle) Render(index int, name string) string {
+	switch p {
+	case DollarN:
+		return "$" + strconv.Itoa(index)
+	case AtPn:
+		return "@p" + strconv.Itoa(index)
+	case ColonN:
+		return ":" + strconv.Itoa(index)
+	case ColonName:
+		if name == "" {
+			return ":" + strconv.Itoa(index)
+		}
+		return ":" + name
+	default:
+		return "?"
+	}
+}
+
+// ParameterPlaceholderStyle sets the placeholder style used to rewrite a canonical `?`-form
+// query with RewriteQuery. ParameterPlaceHolder remains as a fallback for custom drivers that
+// don't fit any of the built-in styles.
+func ParameterPlaceholderStyle(style PlaceholderStyle) DataOption {
+	return func(d *DataInfo) error {
+		d.ParameterPlaceholderStyle = style
+		return nil
+	}
+}
+
+// renderPlaceholder renders the placeholder RewriteQuery substitutes for the 1-based index.
+// When ParameterPlaceholderStyle is left at its zero value (Question) and ParameterPlaceHolder
+// carries something other than the default `?`, that custom string is used instead, numbered
+// per ParameterInSequence, so custom drivers keep working without picking a built-in style.
+func (d *DataInfo) renderPlaceholder(index int, name string) string {
+	if d.ParameterPlaceholderStyle != Question {
+		return d.ParameterPlaceholderStyle.Render(index, name)
+	}
+	holder := "?"
+	if d.ParameterPlaceHolder != nil && *d.ParameterPlaceHolder != "" {
+		holder = *d.ParameterPlaceHolder
+	}
+	if holder == "?" {
+		return "?"
+	}
+	if d.ParameterInSequence != nil && !*d.ParameterInSequence {
+		return holder
+	}
+	return holder + strconv.Itoa(index)
+}
+
+// RewriteQuery converts a canonical `?`-form query into the target dialect's placeholders,
+// e.g. `$1,$2,...` for postgres or `@p1,@p2,...` for sqlserver. namedOrPositional supplies
+// one entry per `?` in sql, in order, and is echoed back unchanged as the returned args so
+// the result can be passed straight to database/sql's Exec/Query. For ColonName, pass a
+// sql.NamedArg{Name: ..., Value: ...} (database/sql) to name the placeholder; database/sql
+// recognizes sql.NamedArg natively, so the returned arg still carries its value. Entries of any other
+// type render as `:N` under ColonName. `?` inside a string literal, delimited by
+// StringEnclosingChar, is left untouched.
+func (d *DataInfo) RewriteQuery(sql string, namedOrPositional ...any) (string, []any, error) {
+	quote := byte('\'')
+	if d.StringEnclosingChar != nil && len(*d.StringEnclosingChar) > 0 {
+		quote = (*d.StringEnclosingChar)[0]
+	}
+
+	var b strings.Builder
+	args := make([]any, 0, len(namedOrPositional))
+	index := 0
+	inString := false
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == quote:
+			inString = !inString
+			b.WriteByte(c)
+		case c == '?' && !inString:
+			var arg any
+			name := ""
+			if index < len(namedOrPositional) {
+				arg = namedOrPositional[index]
+				if na, ok := arg.(dbsql.NamedArg); ok {
+					name = na.Name
+				}
+			}
+			index++
+			b.WriteString(d.renderPlaceholder(index, name))
+			args = append(args, arg)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if index != len(namedOrPositional) {
+		return "", nil, fmt.Errorf("datainfo: query has %d placeholders, got %d parameters", index, len(namedOrPositional))
+	}
+	return b.String(), args, nil
+}
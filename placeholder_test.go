@@ -0,0 +1,105 @@
+package datainfo
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestRewriteQueryDialects(t *testing.T) {
+	cases := []struct {
+		name   string
+		di     *DataInfo
+		sql    string
+		params []any
+		want   string
+	}{
+		{
+			name:   "postgres dialect via NewMinimal",
+			di:     NewMinimal("cs", "sch", "postgres", "user"),
+			sql:    "select * from t where a = ? and b = ?",
+			params: []any{1, 2},
+			want:   "select * from t where a = $1 and b = $2",
+		},
+		{
+			name:   "oracle dialect",
+			di:     New(Dialect("oracle")),
+			sql:    "select * from t where a = ?",
+			params: []any{1},
+			want:   "select * from t where a = :1",
+		},
+		{
+			name:   "sqlserver dialect",
+			di:     New(Dialect("sqlserver")),
+			sql:    "select * from t where a = ?",
+			params: []any{1},
+			want:   "select * from t where a = @p1",
+		},
+		{
+			name:   "mysql dialect keeps bare question marks",
+			di:     New(Dialect("mysql")),
+			sql:    "select * from t where a = ? and b = ?",
+			params: []any{1, 2},
+			want:   "select * from t where a = ? and b = ?",
+		},
+		{
+			name:   "custom driver falls back to ParameterPlaceHolder",
+			di:     New(ParameterPlaceHolder("@"), ParameterInSequence(true)),
+			sql:    "select * from t where a = ? and b = ?",
+			params: []any{1, 2},
+			want:   "select * from t where a = @1 and b = @2",
+		},
+		{
+			name:   "question mark inside a string literal is left untouched",
+			di:     New(Dialect("postgres")),
+			sql:    "select * from t where a = ? and b = 'is this ok?'",
+			params: []any{1},
+			want:   "select * from t where a = $1 and b = 'is this ok?'",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, args, err := c.di.RewriteQuery(c.sql, c.params...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Fatalf("got %q, want %q", got, c.want)
+			}
+			if len(args) != len(c.params) {
+				t.Fatalf("got %d args, want %d", len(args), len(c.params))
+			}
+		})
+	}
+}
+
+func TestRewriteQueryParamCountMismatch(t *testing.T) {
+	di := New(Dialect("postgres"))
+	if _, _, err := di.RewriteQuery("select * from t where a = ? and b = ?", 1); err == nil {
+		t.Fatal("expected an error for a parameter count mismatch")
+	}
+}
+
+func TestRewriteQueryColonNameCarriesValue(t *testing.T) {
+	di := New(ParameterPlaceholderStyle(ColonName))
+	got, args, err := di.RewriteQuery(
+		"select * from t where a = ? and b = ?",
+		sql.NamedArg{Name: "owner", Value: "alice"},
+		sql.NamedArg{Name: "status", Value: "active"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "select * from t where a = :owner and b = :status"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if len(args) != 2 {
+		t.Fatalf("got %d args, want 2", len(args))
+	}
+	na, ok := args[0].(sql.NamedArg)
+	if !ok {
+		t.Fatalf("args[0] is %T, want sql.NamedArg", args[0])
+	}
+	if na.Name != "owner" || na.Value != "alice" {
+		t.Fatalf("args[0] = %+v, want Name=owner Value=alice", na)
+	}
+}
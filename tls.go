@@ -0,0 +1,237 @@
+package datainfo
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TLSConfig carries the TLS/SSL settings RenderConnectionString merges into
+// ConnectionString using whichever keys the target driver expects.
+type TLSConfig struct {
+	Mode               string // disable, require, verify-ca or verify-full
+	RootCert           string
+	ClientCert         string
+	ClientKey          string
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// TLS sets the TLS/SSL configuration merged into ConnectionString by RenderConnectionString.
+func TLS(cfg TLSConfig) DataOption {
+	return func(d *DataInfo) error {
+		c := cfg
+		d.TLS = &c
+		return nil
+	}
+}
+
+// RenderConnectionString returns ConnectionString with TLS merged in using the keys the
+// driver named by DriverName expects (sslmode=/sslrootcert=/... for postgres, encrypt=/
+// TrustServerCertificate=/... for mssql, a tls= parameter naming a custom config for mysql;
+// the mysql tls.Config itself is not registered by this method). Calling it repeatedly is idempotent: existing TLS keys are replaced
+// rather than duplicated. When TLS is unset, ConnectionString is returned unchanged.
+func (d *DataInfo) RenderConnectionString() (string, error) {
+	if d.ConnectionString == nil {
+		return "", fmt.Errorf("datainfo: connection string not set")
+	}
+	if d.TLS == nil {
+		return *d.ConnectionString, nil
+	}
+	driver := ""
+	if d.DriverName != nil {
+		driver = *d.DriverName
+	}
+	switch strings.ToLower(driver) {
+	case "mssql", "sqlserver":
+		return renderMSSQLTLS(*d.ConnectionString, d.TLS), nil
+	case "postgres", "pgx":
+		return renderPostgresTLS(*d.ConnectionString, d.TLS), nil
+	case "mysql":
+		return renderMySQLTLS(*d.ConnectionString, d.TLS), nil
+	default:
+		return *d.ConnectionString, nil
+	}
+}
+
+// postgresSSLMode maps TLSConfig.Mode onto postgres' own sslmode spelling. Any non-empty Mode
+// is passed through unchanged; an empty Mode becomes require when InsecureSkipVerify is set
+// and verify-full otherwise.
+func postgresSSLMode(cfg *TLSConfig) string {
+	switch cfg.Mode {
+	case "disable", "require", "verify-ca", "verify-full":
+		return cfg.Mode
+	case "":
+		if cfg.InsecureSkipVerify {
+			return "require"
+		}
+		return "verify-full"
+	default:
+		return cfg.Mode
+	}
+}
+
+func renderPostgresTLS(connStr string, cfg *TLSConfig) string {
+	if strings.Contains(connStr, "://") {
+		u, err := url.Parse(connStr)
+		if err == nil {
+			q := u.Query()
+			q.Set("sslmode", postgresSSLMode(cfg))
+			setOrDelete(q, "sslrootcert", cfg.RootCert)
+			setOrDelete(q, "sslcert", cfg.ClientCert)
+			setOrDelete(q, "sslkey", cfg.ClientKey)
+			u.RawQuery = q.Encode()
+			return u.String()
+		}
+	}
+	kv := map[string]string{
+		"sslmode":     postgresSSLMode(cfg),
+		"sslrootcert": cfg.RootCert,
+		"sslcert":     cfg.ClientCert,
+		"sslkey":      cfg.ClientKey,
+	}
+	return upsertSpaceSeparatedKV(connStr, kv)
+}
+
+func renderMSSQLTLS(connStr string, cfg *TLSConfig) string {
+	kv := map[string]string{
+		"encrypt":                strconv.FormatBool(cfg.Mode != "disable"),
+		"trustservercertificate": strconv.FormatBool(cfg.InsecureSkipVerify),
+		"certificate":            cfg.RootCert,
+		"hostnameincertificate":  cfg.ServerName,
+	}
+	if strings.Contains(connStr, "://") {
+		u, err := url.Parse(connStr)
+		if err == nil {
+			q := u.Query()
+			for k, v := range kv {
+				setOrDelete(q, k, v)
+			}
+			u.RawQuery = q.Encode()
+			return u.String()
+		}
+	}
+	return upsertSemicolonKV(connStr, kv)
+}
+
+// renderMySQLTLS folds TLS into the DSN as a `tls=` parameter: `tls=false` for Mode disable,
+// `tls=skip-verify` when InsecureSkipVerify is set, otherwise `tls=custom`, or
+// `tls=custom-<ServerName>` when ServerName is set. No tls.Config is registered here; the
+// caller registers one under that custom name with mysql.RegisterTLSConfig.
+func renderMySQLTLS(connStr string, cfg *TLSConfig) string {
+	name := "custom"
+	if cfg.ServerName != "" {
+		name = "custom-" + cfg.ServerName
+	}
+	tlsValue := name
+	if cfg.Mode == "disable" {
+		tlsValue = "false"
+	} else if cfg.InsecureSkipVerify {
+		tlsValue = "skip-verify"
+	}
+
+	idx := strings.Index(connStr, "?")
+	if idx < 0 {
+		return connStr + "?tls=" + tlsValue
+	}
+	base, query := connStr[:idx], connStr[idx+1:]
+	params := strings.Split(query, "&")
+	out := make([]string, 0, len(params)+1)
+	replaced := false
+	for _, p := range params {
+		if p == "" || strings.HasPrefix(p, "tls=") {
+			if !replaced {
+				out = append(out, "tls="+tlsValue)
+				replaced = true
+			}
+			continue
+		}
+		out = append(out, p)
+	}
+	if !replaced {
+		out = append(out, "tls="+tlsValue)
+	}
+	return base + "?" + strings.Join(out, "&")
+}
+
+func setOrDelete(q url.Values, key, value string) {
+	if value == "" {
+		q.Del(key)
+		return
+	}
+	q.Set(key, value)
+}
+
+func upsertSpaceSeparatedKV(connStr string, kv map[string]string) string {
+	fields := strings.Fields(connStr)
+	keySet := map[string]bool{}
+	out := fields[:0:0]
+	for _, f := range fields {
+		key := f
+		if eq := strings.IndexByte(f, '='); eq >= 0 {
+			key = f[:eq]
+		}
+		if v, ok := kv[key]; ok {
+			keySet[key] = true
+			if v == "" {
+				continue
+			}
+			out = append(out, key+"="+v)
+			continue
+		}
+		out = append(out, f)
+	}
+	for _, key := range sortedKeys(kv) {
+		if v := kv[key]; !keySet[key] && v != "" {
+			out = append(out, key+"="+v)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+var semicolonKVKey = regexp.MustCompile(`(?i)^\s*([^=;]+)\s*=`)
+
+func upsertSemicolonKV(connStr string, kv map[string]string) string {
+	parts := strings.Split(connStr, ";")
+	keySet := map[string]bool{}
+	out := parts[:0:0]
+	for _, p := range parts {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		m := semicolonKVKey.FindStringSubmatch(p)
+		if m == nil {
+			out = append(out, p)
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(m[1]))
+		if v, ok := kv[key]; ok {
+			keySet[key] = true
+			if v == "" {
+				continue
+			}
+			out = append(out, m[1]+"="+v)
+			continue
+		}
+		out = append(out, p)
+	}
+	for _, key := range sortedKeys(kv) {
+		if v := kv[key]; !keySet[key] && v != "" {
+			out = append(out, key+"="+v)
+		}
+	}
+	return strings.Join(out, ";")
+}
+
+// sortedKeys returns kv's keys in sorted order so upsert output is deterministic.
+func sortedKeys(kv map[string]string) []string {
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
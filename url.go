@@ -0,0 +1,138 @@
+package datainfo
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewFromURL builds a DataInfo from a scheme-qualified config URL, e.g.
+//
+//	datainfo://postgres/myschema?paramPlaceholder=$&maxOpenConnections=25&connMaxLifetime=5m&ping=1
+//
+// The host is taken as the driver name and the path as the schema. The query string
+// sets the placeholder, quoting, connection pool, ping, limit position, reference mode
+// and init statement settings (the keys urlOption recognizes), so operators get a single
+// env-var-friendly connection knob instead of calling option functions one by one.
+// ConnectionString, HelperID, InterpolateTables, ParameterPlaceholderStyle,
+// SessionTimeZone, TLS, SequenceGenerator and ConnectionStringRewriter are not read from
+// the URL.
+// Unknown query keys are rejected with the offending key named in the error.
+// Further options are applied after the URL, so they take precedence over it.
+func NewFromURL(raw string, options ...DataOption) (*DataInfo, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("datainfo: parse url: %w", err)
+	}
+
+	opts := []DataOption{
+		DriverName(u.Host),
+		Schema(strings.TrimPrefix(u.Path, "/")),
+	}
+
+	for key, values := range u.Query() {
+		for _, v := range values {
+			opt, err := urlOption(key, v)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, opt)
+		}
+	}
+
+	if len(options) > 0 {
+		opts = append(opts, options...)
+	}
+	return New(opts...), nil
+}
+
+// urlOption translates a single query key/value pair from a NewFromURL connection
+// URL into the matching DataOption.
+func urlOption(key, value string) (DataOption, error) {
+	switch key {
+	case "paramPlaceholder":
+		return ParameterPlaceHolder(value), nil
+	case "paramInSequence":
+		b, err := parseURLBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return ParameterInSequence(b), nil
+	case "stringEnclosingChar":
+		return StringEnclosingChar(value), nil
+	case "stringEscapeChar":
+		return StringEscapeChar(value), nil
+	case "reservedWordEscapeChar":
+		return ReservedWordEscapeChar(value), nil
+	case "maxOpenConnections":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return MaxOpenConnection(n), nil
+	case "maxIdleConnections":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return MaxIdleConnection(n), nil
+	case "connMaxLifetime":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return MaxConnectionLifetime(int(d)), nil
+	case "connMaxIdleTime":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return MaxConnectionIdleTime(int(d)), nil
+	case "ping":
+		b, err := parseURLBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return Ping(b), nil
+	case "limitPosition":
+		switch strings.ToLower(value) {
+		case "front":
+			return ResultLimitPosition(FRONT), nil
+		case "rear":
+			return ResultLimitPosition(REAR), nil
+		default:
+			return nil, fmt.Errorf("datainfo: url parameter %q: unknown limit position %q", key, value)
+		}
+	case "refMode":
+		b, err := parseURLBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("datainfo: url parameter %q: %w", key, err)
+		}
+		return ReferenceMode(b), nil
+	case "refPrefix":
+		return ReferenceModePrefix(value), nil
+	case "onInit":
+		stmt := value
+		return func(d *DataInfo) error {
+			d.InitStatements = append(d.InitStatements, stmt)
+			return nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("datainfo: unknown url parameter %q", key)
+	}
+}
+
+// parseURLBool accepts the same boolean spellings a DataInfo connection URL
+// commonly carries: 0/1 as well as true/false.
+func parseURLBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "1", "true":
+		return true, nil
+	case "0", "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean %q", value)
+	}
+}
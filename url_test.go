@@ -0,0 +1,100 @@
+package datainfo
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewFromURLHappyPath(t *testing.T) {
+	raw := "datainfo://postgres/myschema?paramPlaceholder=%24&paramInSequence=1&maxOpenConnections=25" +
+		"&maxIdleConnections=25&connMaxLifetime=5m&connMaxIdleTime=3m&ping=1&limitPosition=rear" +
+		"&refMode=1&refPrefix=ref&onInit=SET+TIME+ZONE+%27UTC%27&onInit=SET+search_path%3Dmyapp"
+
+	di, err := NewFromURL(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if di.DriverName == nil || *di.DriverName != "postgres" {
+		t.Errorf("DriverName: got %v, want postgres", di.DriverName)
+	}
+	if di.Schema == nil || *di.Schema != "myschema" {
+		t.Errorf("Schema: got %v, want myschema", di.Schema)
+	}
+	if di.ParameterPlaceHolder == nil || *di.ParameterPlaceHolder != "$" {
+		t.Errorf("ParameterPlaceHolder: got %v, want $", di.ParameterPlaceHolder)
+	}
+	if di.ParameterInSequence == nil || !*di.ParameterInSequence {
+		t.Errorf("ParameterInSequence: got %v, want true", di.ParameterInSequence)
+	}
+	if di.MaxOpenConnection == nil || *di.MaxOpenConnection != 25 {
+		t.Errorf("MaxOpenConnection: got %v, want 25", di.MaxOpenConnection)
+	}
+	if di.MaxIdleConnection == nil || *di.MaxIdleConnection != 25 {
+		t.Errorf("MaxIdleConnection: got %v, want 25", di.MaxIdleConnection)
+	}
+	if di.MaxConnectionLifetime == nil || *di.MaxConnectionLifetime != int(5*time.Minute) {
+		t.Errorf("MaxConnectionLifetime: got %v, want %v", di.MaxConnectionLifetime, int(5*time.Minute))
+	}
+	if di.MaxConnectionIdleTime == nil || *di.MaxConnectionIdleTime != int(3*time.Minute) {
+		t.Errorf("MaxConnectionIdleTime: got %v, want %v", di.MaxConnectionIdleTime, int(3*time.Minute))
+	}
+	if di.Ping == nil || !*di.Ping {
+		t.Errorf("Ping: got %v, want true", di.Ping)
+	}
+	if di.ResultLimitPosition != REAR {
+		t.Errorf("ResultLimitPosition: got %v, want REAR", di.ResultLimitPosition)
+	}
+	if di.ReferenceMode == nil || !*di.ReferenceMode {
+		t.Errorf("ReferenceMode: got %v, want true", di.ReferenceMode)
+	}
+	if di.ReferenceModePrefix == nil || *di.ReferenceModePrefix != "ref" {
+		t.Errorf("ReferenceModePrefix: got %v, want ref", di.ReferenceModePrefix)
+	}
+	wantInit := []string{`SET TIME ZONE 'UTC'`, "SET search_path=myapp"}
+	if len(di.InitStatements) != len(wantInit) {
+		t.Fatalf("InitStatements: got %v, want %v", di.InitStatements, wantInit)
+	}
+	for i, stmt := range wantInit {
+		if di.InitStatements[i] != stmt {
+			t.Errorf("InitStatements: got %v, want %v", di.InitStatements, wantInit)
+		}
+	}
+}
+
+func TestNewFromURLOptionsOverrideURL(t *testing.T) {
+	di, err := NewFromURL("datainfo://postgres/myschema?paramPlaceholder=%24", ParameterPlaceHolder("@"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if di.ParameterPlaceHolder == nil || *di.ParameterPlaceHolder != "@" {
+		t.Fatalf("explicit options should override the URL, got %v", di.ParameterPlaceHolder)
+	}
+}
+
+func TestNewFromURLErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+	}{
+		{"unknown key", "datainfo://postgres/sch?bogusKey=1"},
+		{"invalid duration", "datainfo://postgres/sch?connMaxLifetime=notaduration"},
+		{"invalid int", "datainfo://postgres/sch?maxOpenConnections=notanumber"},
+		{"invalid bool", "datainfo://postgres/sch?ping=maybe"},
+		{"invalid limit position", "datainfo://postgres/sch?limitPosition=sideways"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := NewFromURL(c.raw); err == nil {
+				t.Fatalf("expected an error for %s", c.raw)
+			}
+		})
+	}
+}
+
+func TestNewFromURLUnknownKeyNamesTheKey(t *testing.T) {
+	_, err := NewFromURL("datainfo://postgres/sch?bogusKey=1")
+	if err == nil || !strings.Contains(err.Error(), "bogusKey") {
+		t.Fatalf("expected the error to name the offending key, got %v", err)
+	}
+}